@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PolicyStatement is a single statement of a policy document, as used by
+// BucketPolicy and IAMRole.
+type PolicyStatement struct {
+	// Effect is either "Allow" or "Deny".
+	//
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Action is the list of actions this statement applies to (e.g. "sos:GetObject").
+	Action []string `json:"action"`
+
+	// Resource is the list of resources this statement applies to.
+	Resource []string `json:"resource,omitempty"`
+
+	// Principal restricts the statement to the given principals.
+	Principal map[string]string `json:"principal,omitempty"`
+
+	// Condition holds the conditions under which the statement is in effect.
+	Condition map[string]string `json:"condition,omitempty"`
+}
+
+// PolicyDocument is a structured policy document, attached to a SOS bucket by
+// BucketPolicy and to a role by IAMRole.
+type PolicyDocument struct {
+	// Statement is the list of statements that make up the policy.
+	Statement []PolicyStatement `json:"statement"`
+}
+
+// BucketPolicyParameters are the configurable fields of a BucketPolicy.
+type BucketPolicyParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the name of the zone in which the referenced bucket lives.
+	Zone string `json:"zone"`
+
+	// +kubebuilder:validation:Required
+
+	// Bucket is the name of the SOS bucket this policy is attached to.
+	// There is no managed resource for SOS buckets in this provider, so this
+	// is a plain bucket name rather than a crossplane Reference/Selector.
+	Bucket string `json:"bucket"`
+
+	// Policy is a structured policy document made of statements.
+	// Mutually exclusive with RawPolicyDocument.
+	//
+	// +optional
+	Policy *PolicyDocument `json:"policy,omitempty"`
+
+	// RawPolicyDocument is a raw JSON-encoded IAM policy document, for cases
+	// not expressible through Policy. Mutually exclusive with Policy.
+	//
+	// +optional
+	RawPolicyDocument string `json:"rawPolicyDocument,omitempty"`
+}
+
+// BucketPolicySpec defines the desired state of a BucketPolicy.
+type BucketPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketPolicyParameters `json:"forProvider"`
+}
+
+// BucketPolicyObservation contains the observed fields of a BucketPolicy.
+type BucketPolicyObservation struct {
+	// PolicyHash is the hash of the policy document currently applied to the bucket.
+	PolicyHash string `json:"policyHash,omitempty"`
+
+	// PolicyVersion is the version of the policy document as reported by exoscale.com.
+	PolicyVersion string `json:"policyVersion,omitempty"`
+}
+
+// BucketPolicyStatus represents the observed state of a BucketPolicy.
+type BucketPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider BucketPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-bucketpolicy,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=bucketpolicies,versions=v1,name=bucketpolicies.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// BucketPolicy is the API for managing IAM policy documents on Exoscale SOS buckets.
+type BucketPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketPolicySpec   `json:"spec"`
+	Status BucketPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketPolicyList contains a list of BucketPolicy.
+type BucketPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketPolicy `json:"items"`
+}
+
+// BucketPolicy type metadata.
+var (
+	BucketPolicyKind             = reflect.TypeOf(BucketPolicy{}).Name()
+	BucketPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BucketPolicyKind}.String()
+	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+}
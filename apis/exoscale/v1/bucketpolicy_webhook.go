@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered
+// for the type.
+func (in *BucketPolicy) ValidateCreate() error {
+	return in.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered
+// for the type.
+func (in *BucketPolicy) ValidateUpdate(_ runtime.Object) error {
+	return in.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered
+// for the type.
+func (in *BucketPolicy) ValidateDelete() error {
+	return nil
+}
+
+func (in *BucketPolicy) validate() error {
+	hasPolicy := in.Spec.ForProvider.Policy != nil
+	hasRaw := in.Spec.ForProvider.RawPolicyDocument != ""
+
+	if hasPolicy && hasRaw {
+		return fmt.Errorf("policy and rawPolicyDocument are mutually exclusive")
+	}
+
+	return nil
+}
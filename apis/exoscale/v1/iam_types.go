@@ -48,11 +48,24 @@ type IAMKeyParameters struct {
 	// Cannot be changed after IAMKey is created.
 	Zone string `json:"zone"`
 
-	// +kubebuilder:validation:Required
-
 	// Services is the exoscale service to which IAMKey gets access to.
 	// Only object storage (sos) service is supported thus the IAMKey will be restricted to access only sos.
+	// Mutually exclusive with RoleRef/RoleSelector.
 	Services ServicesSpec `json:"services,omitempty"`
+
+	// RoleID is the ID of the IAMRole to attach this key to, instead of the
+	// role generated from Services. Mutually exclusive with Services.
+	// +optional
+	RoleID string `json:"roleID,omitempty"`
+
+	// RoleRef references an IAMRole to attach this key to, instead of the
+	// role generated from Services. Mutually exclusive with Services.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an IAMRole.
+	// +optional
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
 }
 
 // IAMKeySpec defines the desired state of an IAMKey.
@@ -91,9 +104,11 @@ type IAMKeyStatus struct {
 // +kubebuilder:printcolumn:name="Key ID",type="string",JSONPath=".status.atProvider.keyID"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
-// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-iamkey,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=iamkeys,versions=v1,name=iamkeys.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/convert,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=iamkeys,versions=v1;v1beta1,name=iamkeys-convert.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
-// IAMKey is the API for creating IAM Object Storage Keys on exoscale.com.
+// IAMKey is the v1 (spoke) API for creating IAM Object Storage Keys on exoscale.com.
+// New fields are added to the v1beta1 (hub) version; v1 is kept for backwards
+// compatibility and converts to/from v1beta1 via ConvertTo/ConvertFrom.
 type IAMKey struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"encoding/json"
+
+	"github.com/haarchri/provider-exoscale/apis/exoscale/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// hubDataAnnotation stashes the v1beta1-only fields that have no v1
+// representation (per-bucket prefix/actions/readOnly/tags constraints, and
+// key Tags) on the v1 resource when converting down from the hub, so they
+// survive a round trip through a v1 client and can be restored on the next
+// conversion back up to v1beta1.
+const hubDataAnnotation = "exoscale.crossplane.io/iamkey-v1beta1-data"
+
+// iamKeyHubData holds the v1beta1-only IAMKey fields stashed in
+// hubDataAnnotation.
+type iamKeyHubData struct {
+	Buckets []v1beta1.SOSBucketConstraint `json:"buckets,omitempty"`
+	Tags    map[string]string             `json:"tags,omitempty"`
+}
+
+// ConvertTo converts this IAMKey (v1, spoke) to the Hub version (v1beta1).
+func (src *IAMKey) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.IAMKey)
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	dst.Spec.ResourceSpec = src.Spec.ResourceSpec
+	dst.Spec.ForProvider.KeyName = src.Spec.ForProvider.KeyName
+	dst.Spec.ForProvider.Zone = src.Spec.ForProvider.Zone
+	dst.Spec.ForProvider.RoleID = src.Spec.ForProvider.RoleID
+	dst.Spec.ForProvider.RoleRef = src.Spec.ForProvider.RoleRef
+	dst.Spec.ForProvider.RoleSelector = src.Spec.ForProvider.RoleSelector
+
+	restored, err := restoreHubData(src)
+	if err != nil {
+		return err
+	}
+	if restored != nil {
+		dst.Spec.ForProvider.Services.SOS.Buckets = mergeBucketConstraints(src.Spec.ForProvider.Services.SOS.Buckets, restored.Buckets)
+		dst.Spec.ForProvider.Tags = restored.Tags
+	} else {
+		dst.Spec.ForProvider.Services.SOS.Buckets = bucketConstraintsFromNames(src.Spec.ForProvider.Services.SOS.Buckets)
+	}
+
+	dst.Status.ResourceStatus = src.Status.ResourceStatus
+	dst.Status.AtProvider.KeyID = src.Status.AtProvider.KeyID
+	dst.Status.AtProvider.RoleID = src.Status.AtProvider.RoleID
+	dst.Status.AtProvider.KeyName = src.Status.AtProvider.KeyName
+	dst.Status.AtProvider.ServicesSpec.SOS.Buckets = bucketConstraintsFromNames(src.Status.AtProvider.ServicesSpec.SOS.Buckets)
+
+	delete(dst.Annotations, hubDataAnnotation)
+
+	return nil
+}
+
+// ConvertFrom converts the Hub version (v1beta1) to this IAMKey (v1, spoke).
+// Fields that only exist on v1beta1 (Tags, and the per-bucket prefix/actions/
+// readOnly/tags constraints) have no v1 representation; they are stashed in
+// hubDataAnnotation so a subsequent ConvertTo can restore them instead of
+// silently dropping them.
+func (dst *IAMKey) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.IAMKey)
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	dst.Spec.ResourceSpec = src.Spec.ResourceSpec
+	dst.Spec.ForProvider.KeyName = src.Spec.ForProvider.KeyName
+	dst.Spec.ForProvider.Zone = src.Spec.ForProvider.Zone
+	dst.Spec.ForProvider.Services.SOS.Buckets = bucketNamesFromConstraints(src.Spec.ForProvider.Services.SOS.Buckets)
+	dst.Spec.ForProvider.RoleID = src.Spec.ForProvider.RoleID
+	dst.Spec.ForProvider.RoleRef = src.Spec.ForProvider.RoleRef
+	dst.Spec.ForProvider.RoleSelector = src.Spec.ForProvider.RoleSelector
+
+	dst.Status.ResourceStatus = src.Status.ResourceStatus
+	dst.Status.AtProvider.KeyID = src.Status.AtProvider.KeyID
+	dst.Status.AtProvider.RoleID = src.Status.AtProvider.RoleID
+	dst.Status.AtProvider.KeyName = src.Status.AtProvider.KeyName
+	dst.Status.AtProvider.ServicesSpec.SOS.Buckets = bucketNamesFromConstraints(src.Status.AtProvider.ServicesSpec.SOS.Buckets)
+
+	return stashHubData(dst, src)
+}
+
+// bucketConstraintsFromNames upconverts a v1 bucket name list to v1beta1
+// bucket constraints with no prefix/action/tag restrictions. Used when no
+// stashed hub data is available to restore from (e.g. the CR was created
+// directly as v1, never having had v1beta1-only fields set).
+func bucketConstraintsFromNames(names []string) []v1beta1.SOSBucketConstraint {
+	if names == nil {
+		return nil
+	}
+	constraints := make([]v1beta1.SOSBucketConstraint, len(names))
+	for i, name := range names {
+		constraints[i] = v1beta1.SOSBucketConstraint{Bucket: name}
+	}
+	return constraints
+}
+
+// bucketNamesFromConstraints downconverts v1beta1 bucket constraints to the
+// plain v1 bucket name list. Any prefix/action/readOnly/tag restrictions are
+// stashed separately via stashHubData so they aren't lost.
+func bucketNamesFromConstraints(constraints []v1beta1.SOSBucketConstraint) []string {
+	if constraints == nil {
+		return nil
+	}
+	names := make([]string, len(constraints))
+	for i, c := range constraints {
+		names[i] = c.Bucket
+	}
+	return names
+}
+
+// mergeBucketConstraints re-applies restored per-bucket constraints onto the
+// current v1 bucket name list, keyed by bucket name. Buckets added via v1
+// (which has no constraint fields) get bare constraints; reordered buckets
+// keep their restored constraints.
+func mergeBucketConstraints(names []string, restored []v1beta1.SOSBucketConstraint) []v1beta1.SOSBucketConstraint {
+	if names == nil {
+		return nil
+	}
+	byName := make(map[string]v1beta1.SOSBucketConstraint, len(restored))
+	for _, c := range restored {
+		byName[c.Bucket] = c
+	}
+	merged := make([]v1beta1.SOSBucketConstraint, len(names))
+	for i, name := range names {
+		if c, ok := byName[name]; ok {
+			merged[i] = c
+			continue
+		}
+		merged[i] = v1beta1.SOSBucketConstraint{Bucket: name}
+	}
+	return merged
+}
+
+// stashHubData records the v1beta1-only IAMKey fields in an annotation on
+// dst so a later ConvertTo can restore them.
+func stashHubData(dst *IAMKey, src *v1beta1.IAMKey) error {
+	data := iamKeyHubData{
+		Buckets: src.Spec.ForProvider.Services.SOS.Buckets,
+		Tags:    src.Spec.ForProvider.Tags,
+	}
+	if len(data.Buckets) == 0 && len(data.Tags) == 0 {
+		delete(dst.Annotations, hubDataAnnotation)
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[hubDataAnnotation] = string(raw)
+
+	return nil
+}
+
+// restoreHubData reads back the v1beta1-only IAMKey fields stashed by
+// stashHubData, if any.
+func restoreHubData(src *IAMKey) (*iamKeyHubData, error) {
+	raw, ok := src.Annotations[hubDataAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	data := &iamKeyHubData{}
+	if err := json.Unmarshal([]byte(raw), data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"github.com/haarchri/provider-exoscale/apis/exoscale/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this IAMRole (v1, spoke) to the Hub version (v1beta1).
+func (src *IAMRole) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.IAMRole)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.ResourceSpec = src.Spec.ResourceSpec
+	dst.Spec.ForProvider.RoleName = src.Spec.ForProvider.RoleName
+	dst.Spec.ForProvider.Zone = src.Spec.ForProvider.Zone
+	dst.Spec.ForProvider.Description = src.Spec.ForProvider.Description
+	dst.Spec.ForProvider.PolicyDocument = policyDocumentToV1beta1(src.Spec.ForProvider.PolicyDocument)
+
+	dst.Status.ResourceStatus = src.Status.ResourceStatus
+	dst.Status.AtProvider.RoleID = src.Status.AtProvider.RoleID
+	dst.Status.AtProvider.RoleName = src.Status.AtProvider.RoleName
+
+	return nil
+}
+
+// ConvertFrom converts the Hub version (v1beta1) to this IAMRole (v1, spoke).
+func (dst *IAMRole) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.IAMRole)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.ResourceSpec = src.Spec.ResourceSpec
+	dst.Spec.ForProvider.RoleName = src.Spec.ForProvider.RoleName
+	dst.Spec.ForProvider.Zone = src.Spec.ForProvider.Zone
+	dst.Spec.ForProvider.Description = src.Spec.ForProvider.Description
+	dst.Spec.ForProvider.PolicyDocument = policyDocumentFromV1beta1(src.Spec.ForProvider.PolicyDocument)
+
+	dst.Status.ResourceStatus = src.Status.ResourceStatus
+	dst.Status.AtProvider.RoleID = src.Status.AtProvider.RoleID
+	dst.Status.AtProvider.RoleName = src.Status.AtProvider.RoleName
+
+	return nil
+}
+
+// policyDocumentToV1beta1 converts a v1 PolicyDocument to its v1beta1
+// equivalent. The two are currently identical field-for-field.
+func policyDocumentToV1beta1(doc PolicyDocument) v1beta1.PolicyDocument {
+	out := v1beta1.PolicyDocument{Statement: make([]v1beta1.PolicyStatement, len(doc.Statement))}
+	for i, s := range doc.Statement {
+		out.Statement[i] = v1beta1.PolicyStatement{
+			Effect:    s.Effect,
+			Action:    s.Action,
+			Resource:  s.Resource,
+			Principal: s.Principal,
+			Condition: s.Condition,
+		}
+	}
+	return out
+}
+
+// policyDocumentFromV1beta1 converts a v1beta1 PolicyDocument to its v1
+// equivalent. The two are currently identical field-for-field.
+func policyDocumentFromV1beta1(doc v1beta1.PolicyDocument) PolicyDocument {
+	out := PolicyDocument{Statement: make([]PolicyStatement, len(doc.Statement))}
+	for i, s := range doc.Statement {
+		out.Statement[i] = PolicyStatement{
+			Effect:    s.Effect,
+			Action:    s.Action,
+			Resource:  s.Resource,
+			Principal: s.Principal,
+			Condition: s.Condition,
+		}
+	}
+	return out
+}
@@ -0,0 +1,5 @@
+package v1beta1
+
+// Hub marks IAMKey as a conversion hub, so other versions of IAMKey can
+// convert to/from it via sigs.k8s.io/controller-runtime/pkg/conversion.
+func (*IAMKey) Hub() {}
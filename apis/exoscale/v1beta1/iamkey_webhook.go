@@ -0,0 +1,42 @@
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered
+// for the type.
+func (in *IAMKey) ValidateCreate() error {
+	return in.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered
+// for the type.
+func (in *IAMKey) ValidateUpdate(_ runtime.Object) error {
+	return in.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered
+// for the type.
+func (in *IAMKey) ValidateDelete() error {
+	return nil
+}
+
+func (in *IAMKey) validate() error {
+	hasServices := len(in.Spec.ForProvider.Services.SOS.Buckets) > 0
+	hasRole := in.Spec.ForProvider.RoleID != "" || in.Spec.ForProvider.RoleRef != nil || in.Spec.ForProvider.RoleSelector != nil
+
+	if hasServices && hasRole {
+		return fmt.Errorf("services and roleRef/roleSelector are mutually exclusive")
+	}
+
+	for _, bucket := range in.Spec.ForProvider.Services.SOS.Buckets {
+		if bucket.Prefix != "" || len(bucket.Actions) > 0 || bucket.ReadOnly || len(bucket.Tags) > 0 {
+			return fmt.Errorf("bucket %q: prefix/actions/readOnly/tags are not yet honored by any controller and must be left unset", bucket.Bucket)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,5 @@
+package v1beta1
+
+// Hub marks IAMRole as a conversion hub, so other versions of IAMRole can
+// convert to/from it via sigs.k8s.io/controller-runtime/pkg/conversion.
+func (*IAMRole) Hub() {}
@@ -0,0 +1,117 @@
+package v1beta1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PolicyStatement is a single statement of an IAM policy document.
+type PolicyStatement struct {
+	// Effect is either "Allow" or "Deny".
+	//
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Action is the list of actions this statement applies to (e.g. "sos:GetObject").
+	Action []string `json:"action"`
+
+	// Resource is the list of resources this statement applies to.
+	Resource []string `json:"resource,omitempty"`
+
+	// Principal restricts the statement to the given principals.
+	Principal map[string]string `json:"principal,omitempty"`
+
+	// Condition holds the conditions under which the statement is in effect.
+	Condition map[string]string `json:"condition,omitempty"`
+}
+
+// PolicyDocument is a structured IAM policy document.
+type PolicyDocument struct {
+	// Statement is the list of statements that make up the policy.
+	Statement []PolicyStatement `json:"statement"`
+}
+
+// IAMRoleParameters are the configurable fields of an IAMRole.
+type IAMRoleParameters struct {
+	// RoleName is the name of the Role as presented in the exoscale.com UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	RoleName string `json:"roleName,omitempty"`
+
+	// +kubebuilder:validation:Required
+
+	// Zone is the name of the zone where the IAM role is created.
+	Zone string `json:"zone"`
+
+	// Description is a human readable description of the role.
+	Description string `json:"description,omitempty"`
+
+	// +kubebuilder:validation:Required
+
+	// PolicyDocument is the structured policy document attached to the role.
+	PolicyDocument PolicyDocument `json:"policyDocument"`
+}
+
+// IAMRoleSpec defines the desired state of an IAMRole.
+type IAMRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IAMRoleParameters `json:"forProvider"`
+}
+
+// IAMRoleObservation contains the observed fields of an IAMRole.
+type IAMRoleObservation struct {
+	// RoleID is the observed unique ID as generated by exoscale.com.
+	RoleID string `json:"roleID,omitempty"`
+
+	// RoleName is the observed role name as generated by exoscale.com.
+	RoleName string `json:"roleName,omitempty"`
+}
+
+// IAMRoleStatus represents the observed state of an IAMRole.
+type IAMRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider IAMRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Role ID",type="string",JSONPath=".status.atProvider.roleID"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:path=/convert,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=iamroles,versions=v1;v1beta1,name=iamroles-convert.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// IAMRole is the API for creating fine-grained IAM Roles on exoscale.com.
+type IAMRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IAMRoleSpec   `json:"spec"`
+	Status IAMRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IAMRoleList contains a list of IAMRole.
+type IAMRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IAMRole `json:"items"`
+}
+
+// IAMRole type metadata.
+var (
+	IAMRoleKind             = reflect.TypeOf(IAMRole{}).Name()
+	IAMRoleGroupKind        = schema.GroupKind{Group: Group, Kind: IAMRoleKind}.String()
+	IAMRoleGroupVersionKind = SchemeGroupVersion.WithKind(IAMRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IAMRole{}, &IAMRoleList{})
+}